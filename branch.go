@@ -0,0 +1,303 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+// Branch describes a single entry parsed from `git branch -a -vv`.
+type Branch struct {
+	Name      string
+	IsCurrent bool
+	Upstream  string
+	Ahead     int
+	Behind    int
+}
+
+// parseBranches turns the output of `git branch -a -vv` into a slice of
+// Branch values. Remote-tracking branches (remotes/origin/...) are kept so
+// they can be offered alongside local branches in the checkout panel.
+func parseBranches(output string) []Branch {
+	var branches []Branch
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		b := Branch{}
+		if strings.HasPrefix(line, "* ") {
+			b.IsCurrent = true
+			line = line[2:]
+		} else {
+			line = strings.TrimPrefix(line, "  ")
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		b.Name = fields[0]
+
+		if strings.HasPrefix(b.Name, "remotes/") && strings.Contains(line, "->") {
+			// Skip symbolic refs like "remotes/origin/HEAD -> origin/main".
+			continue
+		}
+
+		if idx := strings.Index(line, "["); idx != -1 {
+			end := strings.Index(line, "]")
+			if end > idx {
+				tracking := line[idx+1 : end]
+				parts := strings.SplitN(tracking, ":", 2)
+				b.Upstream = parts[0]
+				if len(parts) == 2 {
+					b.Ahead, b.Behind = parseAheadBehind(parts[1])
+				}
+			}
+		}
+
+		branches = append(branches, b)
+	}
+
+	return branches
+}
+
+// parseAheadBehind extracts the ahead/behind counts from the tracking
+// fragment of `git branch -vv`, e.g. "ahead 2, behind 1".
+func parseAheadBehind(tracking string) (ahead, behind int) {
+	for _, part := range strings.Split(tracking, ",") {
+		part = strings.TrimSpace(part)
+		fields := strings.Fields(part)
+		if len(fields) != 2 {
+			continue
+		}
+		n, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case "ahead":
+			ahead = n
+		case "behind":
+			behind = n
+		}
+	}
+	return ahead, behind
+}
+
+// listBranches fetches and parses the current branch list.
+func listBranches() ([]Branch, error) {
+	result, err := newGit().Branches("-a", "-vv")
+	if err != nil {
+		return nil, err
+	}
+	return parseBranches(result), nil
+}
+
+// handleBranchManagement drives the "Manage Branches" submenu: checkout,
+// create, delete, rename, and force-checkout.
+func handleBranchManagement() string {
+	var action string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Branch Action").
+				Options(
+					huh.NewOption("Checkout Branch", "checkout"),
+					huh.NewOption("Create Branch", "create"),
+					huh.NewOption("Delete Branch", "delete"),
+					huh.NewOption("Rename Branch", "rename"),
+					huh.NewOption("Force Checkout", "force-checkout"),
+				).
+				Value(&action),
+		),
+	).WithTheme(huh.ThemeCatppuccin())
+
+	if err := form.Run(); err != nil {
+		return "Branch management cancelled."
+	}
+
+	switch action {
+	case "checkout":
+		return checkoutBranchPanel(false)
+	case "force-checkout":
+		return checkoutBranchPanel(true)
+	case "create":
+		return createBranchPanel()
+	case "delete":
+		return deleteBranchPanel()
+	case "rename":
+		return renameBranchPanel()
+	}
+
+	return "Unknown branch action."
+}
+
+// checkoutBranchPanel lists local and remote branches, marks the currently
+// checked-out one, and refuses to re-checkout it - mirroring lazygit's
+// guard against checking out the branch you're already on.
+func checkoutBranchPanel(force bool) string {
+	branches, err := listBranches()
+	if err != nil {
+		return formatGitResult("", err)
+	}
+	if len(branches) == 0 {
+		return "No branches found."
+	}
+
+	options := make([]huh.Option[string], 0, len(branches))
+	for _, b := range branches {
+		label := b.Name
+		if b.IsCurrent {
+			label = "* " + label + " (current)"
+		}
+		options = append(options, huh.NewOption(label, b.Name))
+	}
+
+	var selected string
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Checkout Branch").
+				Options(options...).
+				Value(&selected),
+		),
+	).WithTheme(huh.ThemeCatppuccin())
+
+	if err := form.Run(); err != nil {
+		return "Checkout cancelled."
+	}
+
+	for _, b := range branches {
+		if b.Name == selected && b.IsCurrent {
+			return fmt.Sprintf("Already on branch %q.", selected)
+		}
+	}
+
+	name := localNameForCheckout(selected)
+
+	git := newGit()
+	if force {
+		return formatGitResult(git.run("checkout", "-f", name))
+	}
+	return formatGitResult(git.run("checkout", name))
+}
+
+// localNameForCheckout strips the "remotes/<remote>/" prefix from a
+// remote-tracking branch so `git checkout <name>` can DWIM a local
+// tracking branch, regardless of which remote it came from (not just
+// origin).
+func localNameForCheckout(selected string) string {
+	rest := strings.TrimPrefix(selected, "remotes/")
+	if rest == selected {
+		return selected
+	}
+
+	parts := strings.SplitN(rest, "/", 2)
+	if len(parts) != 2 {
+		return selected
+	}
+	return parts[1]
+}
+
+func createBranchPanel() string {
+	var name string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("New Branch Name").
+				Value(&name),
+		),
+	).WithTheme(huh.ThemeCatppuccin())
+
+	if err := form.Run(); err != nil || name == "" {
+		return "Branch name cannot be empty."
+	}
+
+	return formatGitResult(newGit().run("checkout", "-b", name))
+}
+
+func deleteBranchPanel() string {
+	branches, err := listBranches()
+	if err != nil {
+		return formatGitResult("", err)
+	}
+
+	options := make([]huh.Option[string], 0, len(branches))
+	for _, b := range branches {
+		if b.IsCurrent || strings.HasPrefix(b.Name, "remotes/") {
+			continue
+		}
+		options = append(options, huh.NewOption(b.Name, b.Name))
+	}
+	if len(options) == 0 {
+		return "No deletable branches found."
+	}
+
+	var selected string
+	var force bool
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Delete Branch").
+				Options(options...).
+				Value(&selected),
+			huh.NewConfirm().
+				Title("Force delete (even if unmerged)?").
+				Value(&force),
+		),
+	).WithTheme(huh.ThemeCatppuccin())
+
+	if err := form.Run(); err != nil {
+		return "Delete cancelled."
+	}
+
+	flag := "-d"
+	if force {
+		flag = "-D"
+	}
+
+	return formatGitResult(newGit().Branches(flag, selected))
+}
+
+func renameBranchPanel() string {
+	branches, err := listBranches()
+	if err != nil {
+		return formatGitResult("", err)
+	}
+
+	options := make([]huh.Option[string], 0, len(branches))
+	for _, b := range branches {
+		if strings.HasPrefix(b.Name, "remotes/") {
+			continue
+		}
+		options = append(options, huh.NewOption(b.Name, b.Name))
+	}
+
+	var selected, newName string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Rename Branch").
+				Options(options...).
+				Value(&selected),
+			huh.NewInput().
+				Title("New Name").
+				Value(&newName),
+		),
+	).WithTheme(huh.ThemeCatppuccin())
+
+	if err := form.Run(); err != nil || newName == "" {
+		return "Rename cancelled."
+	}
+
+	return formatGitResult(newGit().Branches("-m", selected, newName))
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Commit is a single entry from `git log`, parsed from a NUL-delimited
+// pretty-format so subjects containing arbitrary punctuation never break
+// the field split.
+type Commit struct {
+	Hash      string
+	ShortHash string
+	Author    string
+	RelDate   string
+	Subject   string
+}
+
+const commitLogFormat = "%H%x00%h%x00%an%x00%ar%x00%s"
+
+// parseCommits turns the output of
+// `git log --pretty=format:<commitLogFormat>` into a slice of Commit.
+func parseCommits(output string) []Commit {
+	var commits []Commit
+
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\x00")
+		if len(fields) != 5 {
+			continue
+		}
+		commits = append(commits, Commit{
+			Hash:      fields[0],
+			ShortHash: fields[1],
+			Author:    fields[2],
+			RelDate:   fields[3],
+			Subject:   fields[4],
+		})
+	}
+
+	return commits
+}
+
+var (
+	diffAddStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#a6e3a1"))
+	diffDelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#f38ba8"))
+	diffHunkStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#89b4fa"))
+)
+
+// renderDiff applies lipgloss styling to +/-/@@ lines of a diff.
+func renderDiff(diff string) string {
+	lines := strings.Split(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+++") || strings.HasPrefix(line, "---"):
+			// Leave file headers unstyled.
+		case strings.HasPrefix(line, "+"):
+			lines[i] = diffAddStyle.Render(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = diffDelStyle.Render(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = diffHunkStyle.Render(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// popScreenMsg asks the parent model to pop the current sub-model and
+// return control to the main menu.
+type popScreenMsg struct{}
+
+type commitScreen int
+
+const (
+	commitScreenList commitScreen = iota
+	commitScreenDiff
+)
+
+// commitBrowserModel is a pushable sub-model: the main model's Update
+// forwards every message except the background fetch messages to it while
+// it's active, and it pops itself by emitting a popScreenMsg once the user
+// backs out to the main menu.
+type commitBrowserModel struct {
+	commits []Commit
+	cursor  int
+	screen  commitScreen
+	diff    string
+	status  string
+}
+
+func newCommitBrowserModel() (*commitBrowserModel, string) {
+	out, err := newGit().Log("--pretty=format:"+commitLogFormat, "-n", "200")
+	if err != nil {
+		return nil, formatGitResult("", err)
+	}
+	return &commitBrowserModel{commits: parseCommits(out)}, ""
+}
+
+func (m *commitBrowserModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *commitBrowserModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch m.screen {
+	case commitScreenDiff:
+		switch keyMsg.String() {
+		case "q", "esc", "backspace":
+			m.screen = commitScreenList
+		}
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "q", "esc":
+		return m, func() tea.Msg { return popScreenMsg{} }
+	case "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down":
+		if m.cursor < len(m.commits)-1 {
+			m.cursor++
+		}
+	case "enter":
+		if len(m.commits) == 0 {
+			break
+		}
+		m.status = m.handleSelection(m.commits[m.cursor])
+	}
+
+	return m, nil
+}
+
+// handleSelection offers the per-commit action submenu. It runs
+// synchronously (huh.Form.Run blocks and takes over the terminal) just
+// like every other action in handleGitAction.
+func (m *commitBrowserModel) handleSelection(c Commit) string {
+	var action string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(fmt.Sprintf("%s %s", c.ShortHash, c.Subject)).
+				Options(
+					huh.NewOption("View Diff", "diff"),
+					huh.NewOption("Checkout", "checkout"),
+					huh.NewOption("Revert", "revert"),
+					huh.NewOption("Cherry-pick", "cherry-pick"),
+					huh.NewOption("Reset --soft", "reset-soft"),
+					huh.NewOption("Reset --mixed", "reset-mixed"),
+					huh.NewOption("Reset --hard", "reset-hard"),
+					huh.NewOption("Copy SHA", "copy-sha"),
+				).
+				Value(&action),
+		),
+	).WithTheme(huh.ThemeCatppuccin())
+
+	if err := form.Run(); err != nil {
+		return "Cancelled."
+	}
+
+	git := newGit()
+
+	switch action {
+	case "diff":
+		out, err := git.run("show", "--stat", "-p", c.Hash)
+		if err != nil {
+			return formatGitResult("", err)
+		}
+		m.diff = renderDiff(out)
+		m.screen = commitScreenDiff
+		return ""
+	case "checkout":
+		return formatGitResult(git.run("checkout", c.Hash))
+	case "revert":
+		return formatGitResult(git.run("revert", c.Hash))
+	case "cherry-pick":
+		return formatGitResult(git.run("cherry-pick", c.Hash))
+	case "reset-soft":
+		return formatGitResult(git.run("reset", "--soft", c.Hash))
+	case "reset-mixed":
+		return formatGitResult(git.run("reset", "--mixed", c.Hash))
+	case "reset-hard":
+		return formatGitResult(git.run("reset", "--hard", c.Hash))
+	case "copy-sha":
+		if err := clipboard.WriteAll(c.Hash); err != nil {
+			return fmt.Sprintf("Failed to copy SHA to clipboard: %s", err)
+		}
+		return fmt.Sprintf("Copied %s to clipboard.", c.Hash)
+	}
+
+	return "Unknown action."
+}
+
+func (m *commitBrowserModel) View() string {
+	var b strings.Builder
+
+	if m.screen == commitScreenDiff {
+		b.WriteString(m.diff)
+		b.WriteString("\n\nPress 'esc' to go back.")
+		return b.String()
+	}
+
+	b.WriteString("Commit Browser\n\n")
+
+	if len(m.commits) == 0 {
+		b.WriteString("No commits found.\n")
+	}
+
+	for i, c := range m.commits {
+		line := fmt.Sprintf("%s  %-20s %-12s %s", c.ShortHash, c.RelDate, c.Author, c.Subject)
+		if i == m.cursor {
+			b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#fab387")).Render("➡ "+line) + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+
+	b.WriteString("\n" + theme.Render(m.status))
+	b.WriteString("\nPress 'esc' to return to the main menu.")
+	return b.String()
+}
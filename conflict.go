@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+// operationKind identifies the in-progress git operation, if any, so the
+// conflict panel can offer the right abort/continue command.
+type operationKind int
+
+const (
+	opNone operationKind = iota
+	opMerge
+	opRebase
+	opCherryPick
+)
+
+// detectOperation checks for the marker files git leaves behind while a
+// merge, rebase, or cherry-pick is in progress.
+func detectOperation() operationKind {
+	switch {
+	case fileExists(".git/MERGE_HEAD"):
+		return opMerge
+	case fileExists(".git/REBASE_HEAD"):
+		return opRebase
+	case fileExists(".git/CHERRY_PICK_HEAD"):
+		return opCherryPick
+	default:
+		return opNone
+	}
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(filepath.Join(repoPath, path))
+	return err == nil
+}
+
+// conflictedFiles parses `git status --porcelain=v2` for unmerged entries
+// (UU, AA, DD, ...) and returns their paths.
+func conflictedFiles() ([]string, error) {
+	out, err := newGit().run("status", "--porcelain=v2")
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, line := range strings.Split(out, "\n") {
+		if !strings.HasPrefix(line, "u ") {
+			continue
+		}
+		// "u <XY> <sub> <m1> <m2> <m3> <mW> <h1> <h2> <h3> <path>" - the
+		// path is unescaped, so it may itself contain spaces. Split on the
+		// ten fixed-width fields and keep the remainder verbatim, the same
+		// way commit.go avoids splitting on whitespace for %s subjects.
+		parts := strings.SplitN(line, " ", 11)
+		if len(parts) != 11 {
+			continue
+		}
+		files = append(files, parts[10])
+	}
+	return files, nil
+}
+
+// handleConflictResolution drives the "Resolve Conflicts" action: it lists
+// conflicted files, offers per-file resolutions, and once the working tree
+// is clean, continues or commits the in-progress operation.
+func handleConflictResolution() string {
+	op := detectOperation()
+	if op == opNone {
+		return "No merge, rebase, or cherry-pick currently in progress."
+	}
+
+	files, err := conflictedFiles()
+	if err != nil {
+		return formatGitResult("", err)
+	}
+
+	if len(files) == 0 {
+		return finishOperation(op)
+	}
+
+	options := make([]huh.Option[string], 0, len(files))
+	for _, f := range files {
+		options = append(options, huh.NewOption(f, f))
+	}
+	options = append(options, huh.NewOption("Abort", "__abort__"))
+
+	var selected string
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Conflicted Files").
+				Options(options...).
+				Value(&selected),
+		),
+	).WithTheme(huh.ThemeCatppuccin())
+
+	if err := form.Run(); err != nil {
+		return "Conflict resolution cancelled."
+	}
+
+	if selected == "__abort__" {
+		return abortOperation(op)
+	}
+
+	return resolveFilePanel(op, selected)
+}
+
+func resolveFilePanel(op operationKind, file string) string {
+	var action string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(fmt.Sprintf("Resolve %s", file)).
+				Options(
+					huh.NewOption("Use Ours", "ours"),
+					huh.NewOption("Use Theirs", "theirs"),
+					huh.NewOption("Open in $EDITOR", "edit"),
+					huh.NewOption("Mark Resolved", "resolved"),
+				).
+				Value(&action),
+		),
+	).WithTheme(huh.ThemeCatppuccin())
+
+	if err := form.Run(); err != nil {
+		return "Resolution cancelled."
+	}
+
+	git := newGit()
+
+	switch action {
+	case "ours":
+		if _, err := git.run("checkout", "--ours", file); err != nil {
+			return formatGitResult("", err)
+		}
+		return formatGitResult(git.Add(file))
+	case "theirs":
+		if _, err := git.run("checkout", "--theirs", file); err != nil {
+			return formatGitResult("", err)
+		}
+		return formatGitResult(git.Add(file))
+	case "edit":
+		if err := openInEditor(file); err != nil {
+			return err.Error()
+		}
+		return fmt.Sprintf("Edited %s. Select it again and choose \"Mark Resolved\" once fixed.", file)
+	case "resolved":
+		return formatGitResult(git.Add(file))
+	}
+
+	return "Unknown resolution action."
+}
+
+func openInEditor(file string) error {
+	// $EDITOR commonly carries its own arguments (e.g. "code --wait",
+	// "vim -u NONE"), so it can't be passed to exec.Command as a single
+	// binary name - split it the same way a shell would word-split it.
+	fields := strings.Fields(os.Getenv("EDITOR"))
+	if len(fields) == 0 {
+		fields = []string{"vi"}
+	}
+	args := append(fields[1:], filepath.Join(repoPath, file))
+
+	cmd := exec.Command(fields[0], args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// finishOperation is reached once no conflicted files remain: it prompts
+// for a commit message (merge) or simply continues (rebase/cherry-pick).
+func finishOperation(op operationKind) string {
+	git := newGit()
+
+	switch op {
+	case opMerge:
+		var message string
+		form := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("Merge Commit Message (blank to keep default)").
+					Value(&message),
+			),
+		).WithTheme(huh.ThemeCatppuccin())
+
+		if err := form.Run(); err != nil {
+			return "Commit cancelled."
+		}
+
+		if message == "" {
+			return formatGitResult(git.run("commit", "--no-edit"))
+		}
+		return formatGitResult(git.Commit(message))
+
+	case opRebase:
+		return formatGitResult(git.run("rebase", "--continue"))
+
+	case opCherryPick:
+		return formatGitResult(git.run("cherry-pick", "--continue"))
+	}
+
+	return "Nothing to finish."
+}
+
+func abortOperation(op operationKind) string {
+	git := newGit()
+
+	switch op {
+	case opMerge:
+		return formatGitResult(git.run("merge", "--abort"))
+	case opRebase:
+		return formatGitResult(git.run("rebase", "--abort"))
+	case opCherryPick:
+		return formatGitResult(git.run("cherry-pick", "--abort"))
+	}
+
+	return "Nothing to abort."
+}
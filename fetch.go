@@ -0,0 +1,188 @@
+package main
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// defaultFetchInterval is how often the background fetch loop runs unless
+// overridden by GITIFY_FETCH_INTERVAL (a Go duration string, e.g. "30s").
+const defaultFetchInterval = 60 * time.Second
+
+func fetchInterval() time.Duration {
+	if raw := os.Getenv("GITIFY_FETCH_INTERVAL"); raw != "" {
+		if d, err := time.ParseDuration(raw); err == nil {
+			return d
+		}
+	}
+	return defaultFetchInterval
+}
+
+// branchTrack holds the ahead/behind counts for one local branch against
+// its upstream, as reported by `git for-each-ref`.
+type branchTrack struct {
+	Name     string
+	Upstream string
+	Ahead    int
+	Behind   int
+}
+
+// fetchTickMsg fires on the fetch timer; fetchResultMsg carries the
+// outcome of the background `git fetch` it triggers.
+type fetchTickMsg time.Time
+
+type fetchResultMsg struct {
+	tracks        []branchTrack
+	currentBranch string
+	err           error
+}
+
+func tickFetchCmd(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
+		return fetchTickMsg(t)
+	})
+}
+
+func fetchCmd() tea.Cmd {
+	return func() tea.Msg {
+		git := newGit()
+
+		if _, err := git.run("fetch", "--all", "--prune"); err != nil {
+			return fetchResultMsg{err: err}
+		}
+
+		return readTracks(git)
+	}
+}
+
+// refreshTracksCmd re-reads branch tracking info and the current branch
+// without hitting the remote - used right after actions that can change
+// ahead/behind counts or the checked-out branch locally (push, pull,
+// merge, fast-forward, checkout) so the badge in View() stays accurate
+// without waiting for the next fetch tick, and without View() itself
+// shelling out.
+func refreshTracksCmd() tea.Cmd {
+	return func() tea.Msg {
+		return readTracks(newGit())
+	}
+}
+
+// readTracks parses `git for-each-ref` into a fetchResultMsg, the shared
+// last step of both fetchCmd (which also runs `git fetch` first) and
+// refreshTracksCmd (which doesn't).
+func readTracks(git *Git) fetchResultMsg {
+	out, err := git.run("for-each-ref", "--format=%(refname:short) %(upstream:short) %(upstream:track)", "refs/heads")
+	if err != nil {
+		return fetchResultMsg{err: err}
+	}
+
+	current, err := git.CurrentBranch()
+	if err != nil {
+		return fetchResultMsg{err: err}
+	}
+
+	return fetchResultMsg{tracks: parseBranchTracks(out), currentBranch: current}
+}
+
+// parseBranchTracks parses lines like:
+//
+//	main origin/main [ahead 2, behind 1]
+//	feature origin/feature
+func parseBranchTracks(output string) []branchTrack {
+	var tracks []branchTrack
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, " ", 2)
+		t := branchTrack{Name: fields[0]}
+		if len(fields) == 2 {
+			rest := strings.TrimSpace(fields[1])
+			if idx := strings.Index(rest, "["); idx != -1 {
+				t.Upstream = strings.TrimSpace(rest[:idx])
+				end := strings.Index(rest, "]")
+				if end > idx {
+					t.Ahead, t.Behind = parseAheadBehind(rest[idx+1 : end])
+				}
+			} else {
+				t.Upstream = rest
+			}
+		}
+
+		tracks = append(tracks, t)
+	}
+
+	return tracks
+}
+
+func trackFor(tracks []branchTrack, name string) (branchTrack, bool) {
+	for _, t := range tracks {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return branchTrack{}, false
+}
+
+var (
+	aheadStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#a6e3a1"))
+	behindStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#f9e2af"))
+	divergedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#f38ba8"))
+)
+
+// renderTrackBadge renders the "↑N ↓M" badge shown next to push/pull,
+// colored green when only ahead, yellow when only behind, and red when
+// diverged in both directions.
+func renderTrackBadge(t branchTrack, ok bool) string {
+	if !ok || t.Upstream == "" {
+		return ""
+	}
+	if t.Ahead == 0 && t.Behind == 0 {
+		return ""
+	}
+
+	badge := "↑" + strconv.Itoa(t.Ahead) + " ↓" + strconv.Itoa(t.Behind)
+
+	switch {
+	case t.Ahead > 0 && t.Behind > 0:
+		return divergedStyle.Render(badge)
+	case t.Ahead > 0:
+		return aheadStyle.Render(badge)
+	case t.Behind > 0:
+		return behindStyle.Render(badge)
+	}
+	return badge
+}
+
+// fastForwardEligible mirrors lazygit's fast-forward guard: it only
+// offers a fast-forward when the branch is behind but not diverged, and
+// the upstream ref is actually present locally.
+func fastForwardEligible(t branchTrack, ok bool) bool {
+	return ok && t.Upstream != "" && t.Behind > 0 && t.Ahead == 0
+}
+
+// handleFastForward runs `git merge --ff-only <upstream>` for the current
+// branch, refusing if the branch isn't eligible.
+func handleFastForward(tracks []branchTrack) string {
+	git := newGit()
+
+	current, err := git.CurrentBranch()
+	if err != nil {
+		return formatGitResult(current, err)
+	}
+
+	t, ok := trackFor(tracks, current)
+	if !fastForwardEligible(t, ok) {
+		return "Fast-forward not available: branch is not behind a known upstream, or has diverged."
+	}
+
+	return formatGitResult(git.run("merge", "--ff-only", t.Upstream))
+}
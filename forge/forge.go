@@ -0,0 +1,128 @@
+// Package forge provides a small, provider-agnostic client for the
+// GitHub/GitLab features gitify surfaces in its "GitHub" menu section:
+// listing issues, opening pull requests, and checking CI status of HEAD.
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Issue is a provider-agnostic view of a GitHub issue or GitLab issue.
+type Issue struct {
+	Number int
+	Title  string
+	URL    string
+}
+
+// PullRequest is a provider-agnostic view of a GitHub pull request or
+// GitLab merge request.
+type PullRequest struct {
+	Number int
+	Title  string
+	URL    string
+}
+
+// CIState is one of the symbols gitify renders for `git` HEAD's CI status:
+// success, failure, pending, or none.
+type CIState string
+
+const (
+	CISuccess CIState = "✓"
+	CIFailure CIState = "✗"
+	CIPending CIState = "…"
+	CINone    CIState = "?"
+)
+
+// Forge is implemented by each hosted git provider gitify talks to.
+type Forge interface {
+	ListIssues(ctx context.Context) ([]Issue, error)
+	CreateIssue(ctx context.Context, title, body string) (Issue, error)
+	ListPullRequests(ctx context.Context) ([]PullRequest, error)
+	CreatePR(ctx context.Context, base, head, title, body string) (PullRequest, error)
+	CIStatus(ctx context.Context, ref string) (CIState, error)
+}
+
+// New inspects originURL and returns the matching Forge implementation.
+// It returns an error if the remote isn't a recognized provider.
+func New(originURL string) (Forge, error) {
+	owner, repo, host, err := parseOriginURL(originURL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch host {
+	case "github.com":
+		return newGitHubForge(owner, repo)
+	case "gitlab.com":
+		return newGitLabForge(owner, repo)
+	default:
+		return nil, fmt.Errorf("forge: unsupported remote host %q", host)
+	}
+}
+
+// IsSupportedHost reports whether originURL points at a host gitify knows
+// how to talk to, without constructing a client (and therefore without
+// needing credentials yet).
+func IsSupportedHost(originURL string) bool {
+	_, _, host, err := parseOriginURL(originURL)
+	if err != nil {
+		return false
+	}
+	return host == "github.com" || host == "gitlab.com"
+}
+
+// parseOriginURL accepts both the HTTPS and SSH forms of a remote URL
+// (https://github.com/owner/repo.git, git@github.com:owner/repo.git) and
+// extracts the host, owner, and repo name.
+func parseOriginURL(raw string) (owner, repo, host string, err error) {
+	raw = strings.TrimSpace(raw)
+	raw = strings.TrimSuffix(raw, ".git")
+
+	if strings.HasPrefix(raw, "git@") {
+		// git@host:owner/repo
+		rest := strings.TrimPrefix(raw, "git@")
+		parts := strings.SplitN(rest, ":", 2)
+		if len(parts) != 2 {
+			return "", "", "", fmt.Errorf("forge: cannot parse remote %q", raw)
+		}
+		host = parts[0]
+		ownerRepo := strings.SplitN(parts[1], "/", 2)
+		if len(ownerRepo) != 2 {
+			return "", "", "", fmt.Errorf("forge: cannot parse remote %q", raw)
+		}
+		return ownerRepo[0], ownerRepo[1], host, nil
+	}
+
+	u, parseErr := url.Parse(raw)
+	if parseErr != nil {
+		return "", "", "", fmt.Errorf("forge: cannot parse remote %q: %w", raw, parseErr)
+	}
+
+	ownerRepo := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 2)
+	if len(ownerRepo) != 2 {
+		return "", "", "", fmt.Errorf("forge: cannot parse remote %q", raw)
+	}
+
+	return ownerRepo[0], ownerRepo[1], u.Host, nil
+}
+
+// tokenFromCLI shells out to a CLI (gh/glab) to read a cached auth token,
+// falling back to the given environment variable.
+func tokenFromCLI(cliArgs []string, envVar string) (string, error) {
+	if out, err := exec.Command(cliArgs[0], cliArgs[1:]...).Output(); err == nil {
+		if token := strings.TrimSpace(string(out)); token != "" {
+			return token, nil
+		}
+	}
+
+	if token := os.Getenv(envVar); token != "" {
+		return token, nil
+	}
+
+	return "", fmt.Errorf("forge: no credentials found (tried %q and $%s)", strings.Join(cliArgs, " "), envVar)
+}
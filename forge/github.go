@@ -0,0 +1,113 @@
+package forge
+
+import (
+	"context"
+
+	"github.com/google/go-github/github"
+	"golang.org/x/oauth2"
+)
+
+// githubForge implements Forge against the GitHub REST API via
+// google/go-github, authenticating with whatever `gh auth token` (or
+// $GITHUB_TOKEN) returns.
+type githubForge struct {
+	client *github.Client
+	owner  string
+	repo   string
+}
+
+func newGitHubForge(owner, repo string) (Forge, error) {
+	token, err := tokenFromCLI([]string{"gh", "auth", "token"}, "GITHUB_TOKEN")
+	if err != nil {
+		return nil, err
+	}
+
+	tc := oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}))
+
+	return &githubForge{
+		client: github.NewClient(tc),
+		owner:  owner,
+		repo:   repo,
+	}, nil
+}
+
+func (f *githubForge) ListIssues(ctx context.Context) ([]Issue, error) {
+	ghIssues, _, err := f.client.Issues.ListByRepo(ctx, f.owner, f.repo, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []Issue
+	for _, gi := range ghIssues {
+		if gi.IsPullRequest() {
+			continue
+		}
+		issues = append(issues, Issue{
+			Number: gi.GetNumber(),
+			Title:  gi.GetTitle(),
+			URL:    gi.GetHTMLURL(),
+		})
+	}
+	return issues, nil
+}
+
+func (f *githubForge) CreateIssue(ctx context.Context, title, body string) (Issue, error) {
+	gi, _, err := f.client.Issues.Create(ctx, f.owner, f.repo, &github.IssueRequest{
+		Title: &title,
+		Body:  &body,
+	})
+	if err != nil {
+		return Issue{}, err
+	}
+
+	return Issue{Number: gi.GetNumber(), Title: gi.GetTitle(), URL: gi.GetHTMLURL()}, nil
+}
+
+func (f *githubForge) ListPullRequests(ctx context.Context) ([]PullRequest, error) {
+	ghPRs, _, err := f.client.PullRequests.List(ctx, f.owner, f.repo, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var prs []PullRequest
+	for _, pr := range ghPRs {
+		prs = append(prs, PullRequest{
+			Number: pr.GetNumber(),
+			Title:  pr.GetTitle(),
+			URL:    pr.GetHTMLURL(),
+		})
+	}
+	return prs, nil
+}
+
+func (f *githubForge) CreatePR(ctx context.Context, base, head, title, body string) (PullRequest, error) {
+	pr, _, err := f.client.PullRequests.Create(ctx, f.owner, f.repo, &github.NewPullRequest{
+		Title: &title,
+		Head:  &head,
+		Base:  &base,
+		Body:  &body,
+	})
+	if err != nil {
+		return PullRequest{}, err
+	}
+
+	return PullRequest{Number: pr.GetNumber(), Title: pr.GetTitle(), URL: pr.GetHTMLURL()}, nil
+}
+
+func (f *githubForge) CIStatus(ctx context.Context, ref string) (CIState, error) {
+	status, _, err := f.client.Repositories.GetCombinedStatus(ctx, f.owner, f.repo, ref, nil)
+	if err != nil {
+		return CINone, err
+	}
+
+	switch status.GetState() {
+	case "success":
+		return CISuccess, nil
+	case "failure", "error":
+		return CIFailure, nil
+	case "pending":
+		return CIPending, nil
+	default:
+		return CINone, nil
+	}
+}
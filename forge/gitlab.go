@@ -0,0 +1,119 @@
+package forge
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// gitlabForge implements Forge against the GitLab API via xanzy/go-gitlab,
+// authenticating with whatever `glab auth status -t` (or $GITLAB_TOKEN)
+// returns.
+type gitlabForge struct {
+	client  *gitlab.Client
+	project string
+}
+
+func newGitLabForge(owner, repo string) (Forge, error) {
+	token, err := glabToken()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := gitlab.NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gitlabForge{
+		client:  client,
+		project: owner + "/" + repo,
+	}, nil
+}
+
+func glabToken() (string, error) {
+	if out, err := exec.Command("glab", "auth", "status", "-t").CombinedOutput(); err == nil {
+		for _, line := range strings.Split(string(out), "\n") {
+			if idx := strings.Index(line, "Token: "); idx != -1 {
+				return strings.TrimSpace(line[idx+len("Token: "):]), nil
+			}
+		}
+	}
+	return tokenFromCLI([]string{"glab", "auth", "status", "-t"}, "GITLAB_TOKEN")
+}
+
+func (f *gitlabForge) ListIssues(ctx context.Context) ([]Issue, error) {
+	glIssues, _, err := f.client.Issues.ListProjectIssues(f.project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []Issue
+	for _, gi := range glIssues {
+		issues = append(issues, Issue{Number: gi.IID, Title: gi.Title, URL: gi.WebURL})
+	}
+	return issues, nil
+}
+
+func (f *gitlabForge) CreateIssue(ctx context.Context, title, body string) (Issue, error) {
+	gi, _, err := f.client.Issues.CreateIssue(f.project, &gitlab.CreateIssueOptions{
+		Title:       &title,
+		Description: &body,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return Issue{}, err
+	}
+
+	return Issue{Number: gi.IID, Title: gi.Title, URL: gi.WebURL}, nil
+}
+
+func (f *gitlabForge) ListPullRequests(ctx context.Context) ([]PullRequest, error) {
+	mrs, _, err := f.client.MergeRequests.ListProjectMergeRequests(f.project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var prs []PullRequest
+	for _, mr := range mrs {
+		prs = append(prs, PullRequest{Number: mr.IID, Title: mr.Title, URL: mr.WebURL})
+	}
+	return prs, nil
+}
+
+func (f *gitlabForge) CreatePR(ctx context.Context, base, head, title, body string) (PullRequest, error) {
+	mr, _, err := f.client.MergeRequests.CreateMergeRequest(f.project, &gitlab.CreateMergeRequestOptions{
+		Title:        &title,
+		Description:  &body,
+		SourceBranch: &head,
+		TargetBranch: &base,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return PullRequest{}, err
+	}
+
+	return PullRequest{Number: mr.IID, Title: mr.Title, URL: mr.WebURL}, nil
+}
+
+func (f *gitlabForge) CIStatus(ctx context.Context, ref string) (CIState, error) {
+	statuses, _, err := f.client.Commits.GetCommitStatuses(f.project, ref, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return CINone, err
+	}
+	if len(statuses) == 0 {
+		return CINone, nil
+	}
+
+	switch statuses[0].Status {
+	case "success":
+		return CISuccess, nil
+	case "failed":
+		return CIFailure, nil
+	case "running", "pending":
+		return CIPending, nil
+	default:
+		return CINone, fmt.Errorf("forge: unrecognized gitlab status %q", statuses[0].Status)
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+
+	"gitify/forge"
+)
+
+var forgeMenuOptions = []string{
+	"List Issues",
+	"Create Issue",
+	"List PRs",
+	"Create PR from Current Branch",
+	"CI Status of HEAD",
+}
+
+// originURL returns the origin remote's URL, or "" if there is none.
+func originURL() string {
+	out, err := newGit().run("remote", "get-url", "origin")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(out)
+}
+
+// forgeAvailable reports whether origin points at a host gitify's forge
+// package knows how to talk to, so the "GitHub" menu section can be shown
+// only when it applies.
+func forgeAvailable() bool {
+	return forge.IsSupportedHost(originURL())
+}
+
+func handleForgeAction(action string) string {
+	f, err := forge.New(originURL())
+	if err != nil {
+		return err.Error()
+	}
+
+	ctx := context.Background()
+
+	switch action {
+	case "List Issues":
+		issues, err := f.ListIssues(ctx)
+		if err != nil {
+			return err.Error()
+		}
+		if len(issues) == 0 {
+			return "No open issues."
+		}
+		var b strings.Builder
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "#%d %s\n%s\n\n", issue.Number, issue.Title, issue.URL)
+		}
+		return b.String()
+
+	case "Create Issue":
+		var title, body string
+		form := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().Title("Title").Value(&title),
+				huh.NewInput().Title("Body").Value(&body),
+			),
+		).WithTheme(huh.ThemeCatppuccin())
+
+		if err := form.Run(); err != nil || title == "" {
+			return "Create issue cancelled."
+		}
+
+		issue, err := f.CreateIssue(ctx, title, body)
+		if err != nil {
+			return err.Error()
+		}
+		return fmt.Sprintf("Created issue #%d: %s", issue.Number, issue.URL)
+
+	case "List PRs":
+		prs, err := f.ListPullRequests(ctx)
+		if err != nil {
+			return err.Error()
+		}
+		if len(prs) == 0 {
+			return "No open pull requests."
+		}
+		var b strings.Builder
+		for _, pr := range prs {
+			fmt.Fprintf(&b, "#%d %s\n%s\n\n", pr.Number, pr.Title, pr.URL)
+		}
+		return b.String()
+
+	case "Create PR from Current Branch":
+		return createPRFromCurrentBranch(f, ctx)
+
+	case "CI Status of HEAD":
+		sha, err := newGit().run("rev-parse", "HEAD")
+		if err != nil {
+			return formatGitResult(sha, err)
+		}
+		state, err := f.CIStatus(ctx, strings.TrimSpace(sha))
+		if err != nil {
+			return err.Error()
+		}
+		return fmt.Sprintf("CI status: %s", state)
+	}
+
+	return "Unknown forge action."
+}
+
+// defaultBaseBranch resolves the repo's actual default branch from
+// origin's symbolic HEAD ref, falling back to "main" only if that ref
+// hasn't been fetched (e.g. a freshly added remote).
+func defaultBaseBranch() string {
+	out, err := newGit().run("symbolic-ref", "--short", "refs/remotes/origin/HEAD")
+	if err != nil {
+		return "main"
+	}
+	return strings.TrimPrefix(strings.TrimSpace(out), "origin/")
+}
+
+// createPRFromCurrentBranch pre-fills the title from the latest commit
+// subject and the body from the commits unique to this branch, then
+// pushes with --set-upstream before calling the forge API.
+func createPRFromCurrentBranch(f forge.Forge, ctx context.Context) string {
+	git := newGit()
+
+	base := defaultBaseBranch()
+	head, err := git.CurrentBranch()
+	if err != nil {
+		return formatGitResult(head, err)
+	}
+
+	title, err := git.run("log", "-1", "--pretty=%s")
+	if err != nil {
+		return formatGitResult(title, err)
+	}
+	title = strings.TrimSpace(title)
+
+	body, err := git.run("log", base+".."+head, "--pretty=%B")
+	if err != nil {
+		return formatGitResult(body, err)
+	}
+
+	if _, err := git.Push("origin", head, true); err != nil {
+		return formatGitResult("", err)
+	}
+
+	pr, err := f.CreatePR(ctx, base, head, title, body)
+	if err != nil {
+		return err.Error()
+	}
+
+	return fmt.Sprintf("Opened PR #%d: %s", pr.Number, pr.URL)
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// errorStyle renders stderr output distinctly from regular command output,
+// so a commit message or file path that happens to contain the word
+// "error" is never mistaken for a failure.
+var errorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#f38ba8"))
+
+// GitError is returned whenever the underlying git invocation exits
+// non-zero. It keeps stdout and stderr separate so callers can make
+// decisions based on the real exit status instead of sniffing for the
+// word "fatal" in combined output.
+type GitError struct {
+	Args     []string
+	Stdout   string
+	Stderr   string
+	ExitCode int
+	Err      error
+}
+
+func (e *GitError) Error() string {
+	return fmt.Sprintf("git %s: %s", strings.Join(e.Args, " "), strings.TrimSpace(e.Stderr))
+}
+
+func (e *GitError) Unwrap() error {
+	return e.Err
+}
+
+// Git runs git commands against Dir, returning a *GitError on failure
+// instead of folding stderr into the result string.
+type Git struct {
+	Dir string
+}
+
+func newGit() *Git {
+	return &Git{Dir: repoPath}
+}
+
+func (g *Git) run(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = g.Dir
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	stdout := string(out)
+	if err != nil {
+		exitCode := -1
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			exitCode = exitErr.ExitCode()
+		}
+		return stdout, &GitError{
+			Args:     args,
+			Stdout:   stdout,
+			Stderr:   stderr.String(),
+			ExitCode: exitCode,
+			Err:      err,
+		}
+	}
+
+	return stdout, nil
+}
+
+func (g *Git) Init() (string, error) {
+	return g.run("init")
+}
+
+func (g *Git) AddRemote(name, url string) (string, error) {
+	return g.run("remote", "add", name, url)
+}
+
+func (g *Git) Add(paths ...string) (string, error) {
+	return g.run(append([]string{"add"}, paths...)...)
+}
+
+func (g *Git) Commit(message string) (string, error) {
+	return g.run("commit", "-am", message)
+}
+
+func (g *Git) Push(remote, branch string, setUpstream bool) (string, error) {
+	args := []string{"push"}
+	if setUpstream {
+		args = append(args, "--set-upstream")
+	}
+	args = append(args, remote, branch)
+	return g.run(args...)
+}
+
+func (g *Git) Pull() (string, error) {
+	return g.run("pull")
+}
+
+func (g *Git) Status(args ...string) (string, error) {
+	return g.run(append([]string{"status"}, args...)...)
+}
+
+func (g *Git) Branches(args ...string) (string, error) {
+	return g.run(append([]string{"branch"}, args...)...)
+}
+
+func (g *Git) Log(args ...string) (string, error) {
+	return g.run(append([]string{"log"}, args...)...)
+}
+
+func (g *Git) Merge(branch string) (string, error) {
+	return g.run("merge", branch)
+}
+
+func (g *Git) Diff(args ...string) (string, error) {
+	return g.run(append([]string{"diff"}, args...)...)
+}
+
+func (g *Git) CurrentBranch() (string, error) {
+	out, err := g.run("rev-parse", "--abbrev-ref", "HEAD")
+	return strings.TrimSpace(out), err
+}
+
+func (g *Git) Remotes() (string, error) {
+	return g.run("remote", "-v")
+}
+
+// formatGitResult renders the outcome of a Git method call for display in
+// the TUI's output pane, switching on the typed error rather than
+// string-matching the output for "error" or "fatal".
+func formatGitResult(out string, err error) string {
+	if err == nil {
+		return out
+	}
+
+	var gitErr *GitError
+	if errors.As(err, &gitErr) {
+		return errorStyle.Render(fmt.Sprintf("git %s failed:\n%s", strings.Join(gitErr.Args, " "), strings.TrimSpace(gitErr.Stderr)))
+	}
+
+	return errorStyle.Render(err.Error())
+}
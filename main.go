@@ -3,7 +3,6 @@ package main
 import (
 	"errors"
 	"fmt"
-	"os/exec"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -14,8 +13,12 @@ import (
 const repoPath = "."
 
 type model struct {
-	menuIndex int
-	output    string
+	menuIndex     int
+	output        string
+	sub           tea.Model
+	branchTracks  []branchTrack
+	currentBranch string
+	fetchErr      error
 }
 
 var menuOptions = []string{
@@ -27,31 +30,25 @@ var menuOptions = []string{
 	"Pull from Remote",
 	"Show Status",
 	"Show Branch",
+	"Manage Branches",
 	"Show Log",
 	"Merge Branch",
+	"Fast-Forward",
+	"Resolve Conflicts",
 	"View Diff",
+	"Stash",
 }
 
 var theme = lipgloss.NewStyle().Foreground(lipgloss.Color("#cdd6f4"))
 
-func executeGitCommand(args ...string) string {
-	cmd := exec.Command("git", args...)
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Sprintf("Error: %s\n%s", err, out)
-	}
-	return string(out)
-}
-
 func getUnstagedFiles() []string {
-	cmd := exec.Command("git", "status", "--porcelain")
-	out, err := cmd.Output()
+	out, err := newGit().Status("--porcelain")
 	if err != nil {
 		return []string{"Error fetching status"}
 	}
 
 	var files []string
-	for _, line := range strings.Split(string(out), "\n") {
+	for _, line := range strings.Split(out, "\n") {
 		if len(line) > 3 {
 			files = append(files, line[3:])
 		}
@@ -60,10 +57,39 @@ func getUnstagedFiles() []string {
 }
 
 func (m model) Init() tea.Cmd {
-	return nil
+	return tea.Batch(fetchCmd(), tickFetchCmd(fetchInterval()))
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// The background fetch loop must keep ticking regardless of whether a
+	// sub-screen (commit browser, stash list) is active, or tea.Tick's
+	// one-shot timer never gets rearmed and ahead/behind tracking stops
+	// for the rest of the process. So these are handled before m.sub
+	// routing, not inside the switch below.
+	switch msg := msg.(type) {
+	case fetchTickMsg:
+		return m, tea.Batch(fetchCmd(), tickFetchCmd(fetchInterval()))
+
+	case fetchResultMsg:
+		m.fetchErr = msg.err
+		if msg.err == nil {
+			m.branchTracks = msg.tracks
+			m.currentBranch = msg.currentBranch
+		}
+		return m, nil
+	}
+
+	if m.sub != nil {
+		if _, ok := msg.(popScreenMsg); ok {
+			m.sub = nil
+			return m, refreshTracksCmd()
+		}
+
+		updated, cmd := m.sub.Update(msg)
+		m.sub = updated
+		return m, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -78,21 +104,73 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.menuIndex++
 			}
 		case "enter":
-			m.output = handleGitAction(menuOptions[m.menuIndex])
+			switch menuOptions[m.menuIndex] {
+			case "Show Log":
+				sub, errOutput := newCommitBrowserModel()
+				if sub == nil {
+					m.output = errOutput
+					break
+				}
+				m.sub = sub
+			case "Fast-Forward":
+				m.output = handleFastForward(m.branchTracks)
+				return m, refreshTracksCmd()
+			case "Stash":
+				output, sub := handleStashMenu()
+				m.output = output
+				if sub != nil {
+					m.sub = sub
+				}
+			default:
+				m.output = handleGitAction(menuOptions[m.menuIndex])
+				return m, refreshTracksCmd()
+			}
 		}
 	}
 	return m, nil
 }
 
+// currentBranchTrack looks up the ahead/behind counts for whatever branch
+// is currently checked out, using the cached branch name and track list
+// from the last background fetch - View() must stay side-effect-free, so
+// it never shells out itself.
+func (m model) currentBranchTrack() (branchTrack, bool) {
+	if m.currentBranch == "" {
+		return branchTrack{}, false
+	}
+	return trackFor(m.branchTracks, m.currentBranch)
+}
+
+// badgeFor returns the "↑N ↓M" badge to render next to a menu option, if
+// any applies to it.
+func (m model) badgeFor(option string, t branchTrack, ok bool) string {
+	switch option {
+	case "Push to Remote", "Pull from Remote":
+		return renderTrackBadge(t, ok)
+	}
+	return ""
+}
+
 func (m model) View() string {
+	if m.sub != nil {
+		return m.sub.View()
+	}
+
 	var b strings.Builder
 	b.WriteString("Gitify - Manage Git Repos\n\n")
 
+	currentTrack, hasTrack := m.currentBranchTrack()
+
 	for i, option := range menuOptions {
+		line := option
+		if badge := m.badgeFor(option, currentTrack, hasTrack); badge != "" {
+			line += " " + badge
+		}
+
 		if i == m.menuIndex {
-			b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#fab387")).Render("➡ " + option) + "\n")
+			b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#fab387")).Render("➡ " + line) + "\n")
 		} else {
-			b.WriteString("  " + option + "\n")
+			b.WriteString("  " + line + "\n")
 		}
 	}
 
@@ -102,9 +180,11 @@ func (m model) View() string {
 }
 
 func handleGitAction(action string) string {
+	git := newGit()
+
 	switch action {
 		case "Initialize Repository":
-			return executeGitCommand("init")
+			return formatGitResult(git.Init())
 
 		case "Add Remote":
 			var remoteName string
@@ -140,15 +220,16 @@ func handleGitAction(action string) string {
 					return "Remote name and URL cannot be empty."
 				}
 
-				addResult := executeGitCommand("remote", "add", remoteName, remoteURL)
-
-				if strings.Contains(addResult, "error") || strings.Contains(addResult, "fatal") {
-					return fmt.Sprintf("Failed to add remote: %s", addResult)
+				if _, err := git.AddRemote(remoteName, remoteURL); err != nil {
+					return errorStyle.Render(fmt.Sprintf("Failed to add remote: %s", err))
 				}
 
-				remotes := executeGitCommand("remote", "-v")
+				remotes, err := git.Remotes()
+				if err != nil {
+					return formatGitResult(remotes, err)
+				}
 
-				return fmt.Sprintf("Remote added successfully: %s -> %s\n\nAll remotes:\n%s", 
+				return fmt.Sprintf("Remote added successfully: %s -> %s\n\nAll remotes:\n%s",
 					remoteName, remoteURL, remotes)
 			}
 
@@ -179,14 +260,14 @@ func handleGitAction(action string) string {
 
 				for _, selected := range selectedOptions {
 					if selected == "select_all" {
-						return executeGitCommand(append([]string{"add"}, files...)...)
+						return formatGitResult(git.Add(files...))
 					}
 					if selected == "deselect_all" {
 						return "No files staged."
 					}
 				}
 
-				return executeGitCommand(append([]string{"add"}, selectedOptions...)...)
+				return formatGitResult(git.Add(selectedOptions...))
 			}
 
 		case "Commit Changes":
@@ -212,16 +293,16 @@ func handleGitAction(action string) string {
 
 			fmt.Printf("Debug: Commit message entered by user: '%s'\n", commitMessage)
 
-			return executeGitCommand("commit", "-am", commitMessage)
+			return formatGitResult(git.Commit(commitMessage))
 
 		case "Push to Remote":
-			currentBranch := strings.TrimSpace(executeGitCommand("rev-parse", "--abbrev-ref", "HEAD"))
-			if currentBranch == "" || strings.Contains(currentBranch, "fatal") {
-				return "Failed to get current branch."
+			currentBranch, err := git.CurrentBranch()
+			if err != nil {
+				return formatGitResult(currentBranch, err)
 			}
 
-			remotesOutput := executeGitCommand("remote")
-			if remotesOutput == "" {
+			remotesOutput, err := git.run("remote")
+			if err != nil || remotesOutput == "" {
 				return "No remotes found. Add a remote first."
 			}
 
@@ -257,48 +338,72 @@ func handleGitAction(action string) string {
 				if branchName == "" {
 					branchName = currentBranch
 				}
-				
-				args := []string{"push"}
-				
-				if setUpstream {
-					args = append(args, "--set-upstream")
-				}
-				
-				args = append(args, selectedRemote, branchName)
-				
-				result := executeGitCommand(args...)
-				
-				if strings.Contains(result, "error") || strings.Contains(result, "fatal") {
-					return fmt.Sprintf("Push failed: %s", result)
+
+				result, err := git.Push(selectedRemote, branchName, setUpstream)
+				if err != nil {
+					return errorStyle.Render(fmt.Sprintf("Push failed: %s", err))
 				}
-				
-				return fmt.Sprintf("Successfully pushed to %s/%s\n\n%s", 
+
+				return fmt.Sprintf("Successfully pushed to %s/%s\n\n%s",
 					selectedRemote, branchName, result)
 			}
 
 		case "Pull from Remote":
-			return executeGitCommand("pull")
+			return formatGitResult(git.Pull())
 
 		case "Show Status":
-			return executeGitCommand("status")
+			return formatGitResult(git.Status())
 
 		case "Show Branch":
-			return executeGitCommand("branch")
+			return formatGitResult(git.Branches())
 
-		case "Show Log":
-			return executeGitCommand("log", "--oneline")
+		case "Manage Branches":
+			return handleBranchManagement()
 
 		case "Merge Branch":
-			return executeGitCommand("merge", "main")
+			return formatGitResult(git.Merge("main"))
+
+		case "Resolve Conflicts":
+			return handleConflictResolution()
 
 		case "View Diff":
-			return executeGitCommand("diff")
+			return formatGitResult(git.Diff())
+
+		case "GitHub":
+			var selected string
+
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewSelect[string]().
+						Title("GitHub").
+						Options(toOptions(forgeMenuOptions)...).
+						Value(&selected),
+				),
+			).WithTheme(huh.ThemeCatppuccin())
+
+			if err := form.Run(); err != nil {
+				return "Cancelled."
+			}
+
+			return handleForgeAction(selected)
 	}
 
 	return "Unknown action."
 }
 
+func toOptions(values []string) []huh.Option[string] {
+	options := make([]huh.Option[string], 0, len(values))
+	for _, v := range values {
+		options = append(options, huh.NewOption(v, v))
+	}
+	return options
+}
+
 func main() {
+	if forgeAvailable() {
+		menuOptions = append(menuOptions, "GitHub")
+	}
+
 	p := tea.NewProgram(model{})
 	_, err := p.Run()
 	if err != nil {
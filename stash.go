@@ -0,0 +1,278 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Stash is a single entry from `git stash list`.
+type Stash struct {
+	Ref     string
+	RelDate string
+	Subject string
+}
+
+const stashListFormat = "%gd|%ar|%s"
+
+// parseStashes turns the output of
+// `git stash list --format=<stashListFormat>` into a slice of Stash.
+func parseStashes(output string) []Stash {
+	var stashes []Stash
+
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.SplitN(line, "|", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		stashes = append(stashes, Stash{Ref: fields[0], RelDate: fields[1], Subject: fields[2]})
+	}
+
+	return stashes
+}
+
+func listStashes() ([]Stash, error) {
+	out, err := newGit().run("stash", "list", "--format="+stashListFormat)
+	if err != nil {
+		return nil, err
+	}
+	return parseStashes(out), nil
+}
+
+// handleStashMenu drives the top-level "Stash" action. Save variants run
+// immediately and return their result; browsing an existing stash needs
+// to re-fetch the list after every mutation, so it pushes stashListModel
+// the same way "Show Log" pushes commitBrowserModel.
+func handleStashMenu() (string, tea.Model) {
+	var action string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Stash").
+				Options(
+					huh.NewOption("Save", "save"),
+					huh.NewOption("Save with Message", "save-message"),
+					huh.NewOption("Save Including Untracked", "save-untracked"),
+					huh.NewOption("Browse Stashes", "browse"),
+				).
+				Value(&action),
+		),
+	).WithTheme(huh.ThemeCatppuccin())
+
+	if err := form.Run(); err != nil {
+		return "Stash cancelled.", nil
+	}
+
+	git := newGit()
+
+	switch action {
+	case "save":
+		var message string
+		var includeUntracked bool
+
+		promptForm := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("Message (optional)").
+					Value(&message),
+				huh.NewConfirm().
+					Title("Include untracked files?").
+					Value(&includeUntracked),
+			),
+		).WithTheme(huh.ThemeCatppuccin())
+
+		if err := promptForm.Run(); err != nil {
+			return "Stash cancelled.", nil
+		}
+
+		args := []string{"stash", "push"}
+		if includeUntracked {
+			args = append(args, "-u")
+		}
+		if message != "" {
+			args = append(args, "-m", message)
+		}
+		return formatGitResult(git.run(args...)), nil
+
+	case "save-message":
+		var message string
+		promptForm := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().Title("Message").Value(&message),
+			),
+		).WithTheme(huh.ThemeCatppuccin())
+
+		if err := promptForm.Run(); err != nil || message == "" {
+			return "Stash cancelled.", nil
+		}
+		return formatGitResult(git.run("stash", "push", "-m", message)), nil
+
+	case "save-untracked":
+		return formatGitResult(git.run("stash", "push", "-u")), nil
+
+	case "browse":
+		sub, errOutput := newStashListModel()
+		if sub == nil {
+			return errOutput, nil
+		}
+		return "", sub
+	}
+
+	return "Unknown stash action.", nil
+}
+
+// stashListModel is a pushable sub-model mirroring commitBrowserModel: it
+// lists stashes and, after Pop/Drop mutate the stash list, re-fetches it.
+type stashListModel struct {
+	stashes []Stash
+	cursor  int
+	screen  commitScreen // reuse list/diff screen states
+	diff    string
+	status  string
+}
+
+func newStashListModel() (*stashListModel, string) {
+	stashes, err := listStashes()
+	if err != nil {
+		return nil, formatGitResult("", err)
+	}
+	return &stashListModel{stashes: stashes}, ""
+}
+
+func (m *stashListModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *stashListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	if m.screen == commitScreenDiff {
+		switch keyMsg.String() {
+		case "q", "esc", "backspace":
+			m.screen = commitScreenList
+		}
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "q", "esc":
+		return m, func() tea.Msg { return popScreenMsg{} }
+	case "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down":
+		if m.cursor < len(m.stashes)-1 {
+			m.cursor++
+		}
+	case "enter":
+		if len(m.stashes) == 0 {
+			break
+		}
+		m.status = m.handleSelection(m.stashes[m.cursor])
+	}
+
+	return m, nil
+}
+
+func (m *stashListModel) handleSelection(s Stash) string {
+	var action string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(fmt.Sprintf("%s %s", s.Ref, s.Subject)).
+				Options(
+					huh.NewOption("Show Diff", "show"),
+					huh.NewOption("Pop", "pop"),
+					huh.NewOption("Apply", "apply"),
+					huh.NewOption("Drop", "drop"),
+				).
+				Value(&action),
+		),
+	).WithTheme(huh.ThemeCatppuccin())
+
+	if err := form.Run(); err != nil {
+		return "Cancelled."
+	}
+
+	git := newGit()
+
+	switch action {
+	case "show":
+		out, err := git.run("stash", "show", "-p", s.Ref)
+		if err != nil {
+			return formatGitResult("", err)
+		}
+		m.diff = renderDiff(out)
+		m.screen = commitScreenDiff
+		return ""
+	case "pop":
+		result := formatGitResult(git.run("stash", "pop", s.Ref))
+		m.refresh()
+		return result
+	case "apply":
+		return formatGitResult(git.run("stash", "apply", s.Ref))
+	case "drop":
+		result := formatGitResult(git.run("stash", "drop", s.Ref))
+		m.refresh()
+		return result
+	}
+
+	return "Unknown action."
+}
+
+// refresh re-fetches the stash list after a mutation (pop/drop), and
+// clamps the cursor so it stays in range.
+func (m *stashListModel) refresh() {
+	stashes, err := listStashes()
+	if err != nil {
+		return
+	}
+	m.stashes = stashes
+	if m.cursor >= len(m.stashes) {
+		m.cursor = len(m.stashes) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+func (m *stashListModel) View() string {
+	var b strings.Builder
+
+	if m.screen == commitScreenDiff {
+		b.WriteString(m.diff)
+		b.WriteString("\n\nPress 'esc' to go back.")
+		return b.String()
+	}
+
+	b.WriteString("Stash List\n\n")
+
+	if len(m.stashes) == 0 {
+		b.WriteString("No stashes found.\n")
+	}
+
+	for i, s := range m.stashes {
+		line := fmt.Sprintf("%-12s %-12s %s", s.Ref, s.RelDate, s.Subject)
+		if i == m.cursor {
+			b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#fab387")).Render("➡ "+line) + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+
+	b.WriteString("\n" + theme.Render(m.status))
+	b.WriteString("\nPress 'esc' to return to the main menu.")
+	return b.String()
+}